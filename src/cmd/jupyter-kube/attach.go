@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func newAttachCmd() *cobra.Command {
+	var readyTimeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "attach <name>",
+		Short: "Port-forward to a running notebook and block until interrupted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, config, err := newManager()
+			if err != nil {
+				return err
+			}
+
+			stopChan := make(chan struct{}, 1)
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				close(stopChan)
+			}()
+
+			fmt.Printf("Attaching to %q. Your Jupyter instance will be available at http://localhost:8888\n", args[0])
+			fmt.Println("Press Ctrl+C to detach.")
+
+			return manager.Attach(context.Background(), config, args[0], readyTimeout, stopChan)
+		},
+	}
+
+	cmd.Flags().DurationVar(&readyTimeout, "ready-timeout", 5*time.Minute, "how long to wait for the notebook's pod to become ready")
+
+	return cmd
+}
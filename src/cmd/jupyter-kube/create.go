@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"jupyter-kube/pkg/jupyter"
+)
+
+func newCreateCmd() *cobra.Command {
+	var (
+		cpu          string
+		memory       string
+		gpu          int
+		storage      string
+		storageClass string
+		storageRWX   bool
+		stateful     bool
+		upload       string
+		expose       string
+		host         string
+		ingressClass string
+		certIssuer   string
+		authProxyURL string
+		gpuType      string
+		image        string
+		command      []string
+		pullSecret   string
+		serviceAcct  string
+		nodeSelector string
+		toleration   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new Jupyter notebook",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, config, err := newManager()
+			if err != nil {
+				return err
+			}
+
+			exposeMode, err := jupyter.ParseExposeMode(expose)
+			if err != nil {
+				return err
+			}
+
+			selector, err := parseNodeSelector(nodeSelector)
+			if err != nil {
+				return err
+			}
+			tolerations, err := parseTolerations(toleration)
+			if err != nil {
+				return err
+			}
+
+			_, err = manager.Create(context.Background(), jupyter.CreateOptions{
+				Name:              args[0],
+				CPU:               cpu,
+				Memory:            memory,
+				GPU:               gpu,
+				GPUType:           gpuType,
+				Image:             image,
+				Command:           command,
+				ImagePullSecret:   pullSecret,
+				ServiceAccount:    serviceAcct,
+				NodeSelector:      selector,
+				Tolerations:       tolerations,
+				Storage:           storage,
+				StorageClass:      storageClass,
+				StorageRWX:        storageRWX,
+				Stateful:          stateful,
+				Expose:            exposeMode,
+				Host:              host,
+				IngressClass:      ingressClass,
+				CertManagerIssuer: certIssuer,
+				AuthProxyURL:      authProxyURL,
+			})
+			if err != nil {
+				return err
+			}
+
+			if upload != "" {
+				fmt.Printf("Waiting for notebook %q to become ready to upload %q...\n", args[0], upload)
+				if _, err := manager.WaitForReady(context.Background(), args[0], 0); err != nil {
+					return err
+				}
+				if err := manager.Upload(context.Background(), config, args[0], upload); err != nil {
+					return fmt.Errorf("uploading %q: %w", upload, err)
+				}
+				fmt.Printf("Uploaded %q to notebook %q.\n", upload, args[0])
+			}
+
+			fmt.Printf("Notebook %q is starting. %s\n", args[0], connectHint(args[0], exposeMode, stateful, certIssuer, host))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cpu, "cpu", "1", "CPU request")
+	cmd.Flags().StringVar(&memory, "memory", "2Gi", "Memory request")
+	cmd.Flags().IntVar(&gpu, "gpu", 0, "GPU request (0 for none)")
+	cmd.Flags().StringVar(&storage, "storage", "", "persistent workspace size (e.g. 20Gi); if unset the notebook runs without a PVC")
+	cmd.Flags().StringVar(&storageClass, "storage-class", "", "StorageClass for the workspace PVC (default: cluster default)")
+	cmd.Flags().BoolVar(&storageRWX, "storage-rwx", false, "request a ReadWriteMany PVC instead of ReadWriteOnce")
+	cmd.Flags().BoolVar(&stateful, "stateful", false, "run as a StatefulSet with a headless service instead of a Deployment")
+	cmd.Flags().StringVar(&upload, "upload", "", "local directory to upload into the notebook's workspace once it's ready")
+	cmd.Flags().StringVar(&expose, "expose", string(jupyter.ExposePortForward), "how to expose the notebook: port-forward, nodeport, loadbalancer, or ingress")
+	cmd.Flags().StringVar(&host, "host", "", "hostname routed to the notebook (required for --expose ingress)")
+	cmd.Flags().StringVar(&ingressClass, "ingress-class", "", "IngressClassName for the ingress (default: cluster default)")
+	cmd.Flags().StringVar(&certIssuer, "cert-manager-issuer", "", "cert-manager ClusterIssuer to request a TLS certificate from")
+	cmd.Flags().StringVar(&authProxyURL, "auth-proxy-url", "", "external auth proxy URL; disables the notebook's built-in token and delegates auth to this proxy (ingress mode only)")
+	cmd.Flags().StringVar(&gpuType, "gpu-type", "", "GPU product to target via nodeSelector nvidia.com/gpu.product (e.g. nvidia-a100)")
+	cmd.Flags().StringVar(&image, "image", "", "notebook image (default: jupyter/base-notebook:latest)")
+	cmd.Flags().StringSliceVar(&command, "command", nil, "override the container command used to start the notebook server (default: start-notebook.sh, for jupyter/base-notebook-family images)")
+	cmd.Flags().StringVar(&pullSecret, "image-pull-secret", "", "name of an image pull secret in the namespace")
+	cmd.Flags().StringVar(&serviceAcct, "service-account", "", "service account the notebook pod runs as")
+	cmd.Flags().StringVar(&nodeSelector, "node-selector", "", "comma-separated key=value node selector entries")
+	cmd.Flags().StringVar(&toleration, "toleration", "", "comma-separated key=value:Effect toleration entries")
+
+	return cmd
+}
+
+// connectHint describes how to reach a just-created notebook, which varies
+// by exposeMode: port-forward needs `attach` held open, while the other
+// modes are reachable directly once their Service/Ingress address is
+// assigned. A stateful notebook's Service is always headless, so nodeport
+// and loadbalancer fall back to the attach hint there.
+func connectHint(name string, exposeMode jupyter.ExposeMode, stateful bool, certIssuer, host string) string {
+	if stateful && (exposeMode == jupyter.ExposeNodePort || exposeMode == jupyter.ExposeLoadBalancer) {
+		return fmt.Sprintf("StatefulSet notebooks get a headless Service, so --expose %s has no effect; use `jupyter-kube attach %s` to connect.", exposeMode, name)
+	}
+	switch exposeMode {
+	case jupyter.ExposeNodePort:
+		return fmt.Sprintf("Exposed via NodePort; run `kubectl get service %s -n %s -o jsonpath='{.spec.ports[0].nodePort}'` to find the port, then reach it at <node-ip>:<port>.", name, namespace)
+	case jupyter.ExposeLoadBalancer:
+		return fmt.Sprintf("Exposed via LoadBalancer; run `kubectl get service %s -n %s` to find its external address once assigned.", name, namespace)
+	case jupyter.ExposeIngress:
+		scheme := "http"
+		if certIssuer != "" {
+			scheme = "https"
+		}
+		return fmt.Sprintf("Exposed via Ingress at %s://%s/.", scheme, host)
+	default:
+		return fmt.Sprintf("Use `jupyter-kube attach %s` to connect.", name)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"jupyter-kube/pkg/jupyter"
+)
+
+func newDeleteCmd() *cobra.Command {
+	var keepStorage bool
+
+	cmd := &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Short:   "Delete a notebook",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, _, err := newManager()
+			if err != nil {
+				return err
+			}
+			return manager.Delete(context.Background(), args[0], jupyter.DeleteOptions{
+				KeepStorage: keepStorage,
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&keepStorage, "keep-storage", false, "preserve the notebook's workspace PVC instead of deleting it")
+
+	return cmd
+}
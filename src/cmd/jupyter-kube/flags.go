@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// parseNodeSelector parses a comma-separated k=v,k2=v2 list into a node
+// selector map.
+func parseNodeSelector(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	selector := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --node-selector entry %q: want key=value", pair)
+		}
+		selector[k] = v
+	}
+	return selector, nil
+}
+
+// parseTolerations parses a comma-separated key=value:Effect,... list into
+// Tolerations. The value may be omitted (key:Effect) for an Exists
+// toleration.
+func parseTolerations(s string) ([]apiv1.Toleration, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var tolerations []apiv1.Toleration
+	for _, entry := range strings.Split(s, ",") {
+		keyValue, effect, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid --toleration entry %q: want key=value:Effect", entry)
+		}
+
+		toleration := apiv1.Toleration{Effect: apiv1.TaintEffect(effect)}
+		if key, value, ok := strings.Cut(keyValue, "="); ok {
+			toleration.Key = key
+			toleration.Value = value
+			toleration.Operator = apiv1.TolerationOpEqual
+		} else {
+			toleration.Key = keyValue
+			toleration.Operator = apiv1.TolerationOpExists
+		}
+
+		tolerations = append(tolerations, toleration)
+	}
+	return tolerations, nil
+}
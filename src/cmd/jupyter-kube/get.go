@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func newGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <name>",
+		Short: "Show a single notebook's status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, _, err := newManager()
+			if err != nil {
+				return err
+			}
+
+			notebook, err := manager.Get(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSTATUS\tKIND")
+			fmt.Fprintf(w, "%s\t%s\t%s\n", notebook.Name, notebook.Status, notebook.Kind)
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List notebooks managed by jupyter-kube in the namespace",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, _, err := newManager()
+			if err != nil {
+				return err
+			}
+
+			notebooks, err := manager.List(context.Background())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSTATUS\tKIND")
+			for _, n := range notebooks {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", n.Name, n.Status, n.Kind)
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func newLogsCmd() *cobra.Command {
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:   "logs <name>",
+		Short: "Print logs from a notebook's pod",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, _, err := newManager()
+			if err != nil {
+				return err
+			}
+			return manager.Logs(context.Background(), args[0], follow, os.Stdout)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "stream logs as they are written")
+
+	return cmd
+}
@@ -0,0 +1,117 @@
+// Command jupyter-kube manages Jupyter notebooks running as workloads in a
+// Kubernetes cluster: create, list, delete, attach (port-forward), and tail
+// logs.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"jupyter-kube/pkg/jupyter"
+)
+
+var (
+	kubeconfig string
+	namespace  string
+	cfgFile    string
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "jupyter-kube",
+		Short: "Launch and manage Jupyter notebooks on Kubernetes",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return loadConfig()
+		},
+	}
+
+	defaultKubeconfig := ""
+	if home := homeDir(); home != "" {
+		defaultKubeconfig = filepath.Join(home, ".kube", "config")
+	}
+
+	root.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default: $HOME/.jupyter-kube.yaml)")
+	root.PersistentFlags().StringVar(&kubeconfig, "kubeconfig", defaultKubeconfig, "absolute path to the kubeconfig file")
+	root.PersistentFlags().StringVar(&namespace, "namespace", apiv1.NamespaceDefault, "namespace to manage notebooks in")
+	viper.BindPFlag("kubeconfig", root.PersistentFlags().Lookup("kubeconfig"))
+	viper.BindPFlag("namespace", root.PersistentFlags().Lookup("namespace"))
+
+	root.AddCommand(newCreateCmd())
+	root.AddCommand(newGetCmd())
+	root.AddCommand(newListCmd())
+	root.AddCommand(newDeleteCmd())
+	root.AddCommand(newAttachCmd())
+	root.AddCommand(newLogsCmd())
+	root.AddCommand(newUploadCmd())
+	root.AddCommand(newDownloadCmd())
+
+	return root
+}
+
+// loadConfig resolves kubeconfig and namespace from, in increasing
+// precedence: an optional config file ($HOME/.jupyter-kube.yaml or
+// --config), JUPYTER_KUBE_* environment variables, and the --kubeconfig/
+// --namespace flags themselves (viper.BindPFlag already wired the flags in
+// as the highest-precedence source). Run as PersistentPreRunE so it sees
+// flags after cobra has parsed them.
+func loadConfig() error {
+	viper.SetEnvPrefix("jupyter_kube")
+	viper.AutomaticEnv()
+
+	if cfgFile != "" {
+		viper.SetConfigFile(cfgFile)
+	} else if home := homeDir(); home != "" {
+		viper.AddConfigPath(home)
+		viper.SetConfigName(".jupyter-kube")
+		viper.SetConfigType("yaml")
+	}
+
+	if err := viper.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFound) {
+			return fmt.Errorf("reading config file: %w", err)
+		}
+	}
+
+	kubeconfig = viper.GetString("kubeconfig")
+	namespace = viper.GetString("namespace")
+	return nil
+}
+
+func newManager() (*jupyter.Manager, *rest.Config, error) {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building kubeconfig: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building clientset: %w", err)
+	}
+
+	return jupyter.NewManager(clientset, namespace), config, nil
+}
+
+func homeDir() string {
+	if h := os.Getenv("HOME"); h != "" {
+		return h
+	}
+	return os.Getenv("USERPROFILE") // windows
+}
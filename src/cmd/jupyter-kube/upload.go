@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"jupyter-kube/pkg/jupyter"
+)
+
+func newUploadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "upload <name> <local-dir>",
+		Short: "Upload a local directory into a notebook's workspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, config, err := newManager()
+			if err != nil {
+				return err
+			}
+			return manager.Upload(context.Background(), config, args[0], args[1])
+		},
+	}
+}
+
+func newDownloadCmd() *cobra.Command {
+	var remoteDir string
+
+	cmd := &cobra.Command{
+		Use:   "download <name> <local-dir>",
+		Short: "Download a directory from a notebook's workspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, config, err := newManager()
+			if err != nil {
+				return err
+			}
+			return manager.Download(context.Background(), config, args[0], remoteDir, args[1])
+		},
+	}
+
+	cmd.Flags().StringVar(&remoteDir, "remote-dir", jupyter.WorkspaceMountPath, "directory inside the notebook's workspace to download")
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// CreateOptions configures a single notebook created via Manager.Create.
+type CreateOptions struct {
+	Name   string
+	CPU    string
+	Memory string
+	GPU    int
+	// GPUType maps to the nodeSelector nvidia.com/gpu.product (e.g.
+	// "nvidia-a100"), targeting a specific GPU node pool in a multi-tenant
+	// cluster.
+	GPUType string
+
+	// Image overrides the default jupyter/base-notebook:latest image.
+	Image string
+	// Command overrides the container command used to launch the notebook
+	// server. Leave empty to run start-notebook.sh, the launcher shipped in
+	// jupyter/base-notebook-family images; set this when Image isn't from
+	// that family and starts the server a different way.
+	Command []string
+	// ImagePullSecret names a secret in the namespace used to pull Image.
+	ImagePullSecret string
+	// ServiceAccount overrides the pod's service account.
+	ServiceAccount string
+
+	// NodeSelector is merged into the pod's node selector alongside
+	// GPUType.
+	NodeSelector map[string]string
+	// Tolerations are added to the pod spec verbatim.
+	Tolerations []apiv1.Toleration
+
+	// Storage is the PVC size (e.g. "20Gi"). Leave empty to run without
+	// persistent storage.
+	Storage string
+	// StorageClass is the StorageClass to request for the PVC. Leave empty
+	// to use the cluster default.
+	StorageClass string
+	// StorageRWX requests a ReadWriteMany PVC instead of ReadWriteOnce.
+	StorageRWX bool
+
+	// Stateful runs the notebook as a StatefulSet with a headless Service
+	// instead of a Deployment with a Service typed by Expose, giving the pod
+	// a stable DNS name. The headless Service ignores ExposeNodePort and
+	// ExposeLoadBalancer. Storage, if set, is requested per-replica via a
+	// volumeClaimTemplate rather than a standalone PVC.
+	Stateful bool
+
+	// Expose selects how the notebook is reached from outside the cluster.
+	// Defaults to ExposePortForward.
+	Expose ExposeMode
+	// Host is the hostname routed to the notebook when Expose is
+	// ExposeIngress.
+	Host string
+	// IngressClass is the IngressClassName set on the Ingress when Expose
+	// is ExposeIngress. Leave empty to use the cluster default.
+	IngressClass string
+	// CertManagerIssuer, if set, attaches a cert-manager cluster-issuer
+	// annotation and a TLS block for Host to the Ingress.
+	CertManagerIssuer string
+	// AuthProxyURL, if set alongside ExposeIngress, disables the notebook's
+	// built-in token auth and instead annotates the Ingress to delegate
+	// auth to an external proxy at this URL.
+	AuthProxyURL string
+
+	// Token is populated by Create itself; callers should leave it unset.
+	Token string
+}
+
+// Create provisions the notebook's workload (a Deployment or, if
+// opts.Stateful, a StatefulSet), its Service, and, for ExposeIngress, an
+// Ingress, labeled so it can later be found by List/Delete. Unless an
+// external auth proxy is configured, a random access token is generated,
+// printed once, and enforced by the notebook server.
+func (m *Manager) Create(ctx context.Context, opts CreateOptions) (*Notebook, error) {
+	ctx = ensureContext(ctx)
+
+	if opts.Expose == "" {
+		opts.Expose = ExposePortForward
+	}
+
+	if opts.Expose == ExposeIngress && opts.Host == "" {
+		return nil, fmt.Errorf("Host is required when Expose is ExposeIngress: an empty Ingress host matches every hostname the controller serves")
+	}
+
+	if opts.Expose != ExposeIngress || opts.AuthProxyURL == "" {
+		token, err := generateToken()
+		if err != nil {
+			return nil, fmt.Errorf("generating access token: %w", err)
+		}
+		opts.Token = token
+	}
+
+	creator := workloadCreatorFor(opts)
+	if err := creator.Create(ctx, m, opts); err != nil {
+		return nil, fmt.Errorf("creating workload: %w", err)
+	}
+
+	if opts.Expose == ExposeIngress {
+		if err := m.createIngress(ctx, opts); err != nil {
+			return nil, fmt.Errorf("creating ingress: %w", err)
+		}
+	}
+
+	if opts.Token != "" {
+		fmt.Printf("Notebook access token (shown once): %s\n", opts.Token)
+	}
+
+	kind := "Deployment"
+	if opts.Stateful {
+		kind = "StatefulSet"
+	}
+	return &Notebook{Name: opts.Name, Status: "Creating", Kind: kind}, nil
+}
+
+func int32Ptr(i int32) *int32 { return &i }
@@ -0,0 +1,80 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// deploymentCreator runs a notebook as a Deployment fronted by a Service
+// whose type follows opts.Expose (ClusterIP, NodePort, or LoadBalancer),
+// with an optional standalone PVC for the workspace.
+type deploymentCreator struct{}
+
+func (deploymentCreator) Create(ctx context.Context, m *Manager, opts CreateOptions) error {
+	var workspacePVC string
+	if opts.Storage != "" {
+		var err error
+		workspacePVC, err = m.ensurePVC(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("provisioning storage: %w", err)
+		}
+	}
+
+	if err := m.createDeployment(ctx, opts, workspacePVC); err != nil {
+		return fmt.Errorf("creating deployment: %w", err)
+	}
+	if err := m.createService(ctx, opts.Name, false, opts.Expose); err != nil {
+		return fmt.Errorf("creating service: %w", err)
+	}
+	return nil
+}
+
+func (deploymentCreator) Delete(ctx context.Context, m *Manager, name string) error {
+	deploymentsClient := m.clientset.AppsV1().Deployments(m.namespace)
+	if err := deploymentsClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Manager) createDeployment(ctx context.Context, opts CreateOptions, workspacePVC string) error {
+	deploymentsClient := m.clientset.AppsV1().Deployments(m.namespace)
+
+	container := notebookContainer(opts)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Name,
+			Labels: managedLabels(opts.Name),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: managedLabels(opts.Name),
+			},
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: managedLabels(opts.Name),
+				},
+				Spec: apiv1.PodSpec{
+					Containers: []apiv1.Container{container},
+				},
+			},
+		},
+	}
+
+	if workspacePVC != "" {
+		attachWorkspacePVC(&deployment.Spec.Template.Spec, workspacePVC)
+	}
+	applyScheduling(&deployment.Spec.Template.Spec, opts)
+
+	result, err := deploymentsClient.Create(ctx, deployment, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created deployment %q.\n", result.GetObjectMeta().GetName())
+	return nil
+}
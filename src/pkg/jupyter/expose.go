@@ -0,0 +1,34 @@
+package jupyter
+
+import "fmt"
+
+// ExposeMode selects how a notebook's Jupyter server is reached from
+// outside the cluster.
+type ExposeMode string
+
+const (
+	// ExposePortForward is the default: the caller reaches the notebook via
+	// `jupyter-kube attach`, which port-forwards to the notebook's pod. The
+	// notebook's Service stays ClusterIP.
+	ExposePortForward ExposeMode = "port-forward"
+	// ExposeNodePort exposes the notebook on a NodePort Service.
+	ExposeNodePort ExposeMode = "nodeport"
+	// ExposeLoadBalancer exposes the notebook on a LoadBalancer Service.
+	ExposeLoadBalancer ExposeMode = "loadbalancer"
+	// ExposeIngress exposes the notebook through an Ingress in front of a
+	// ClusterIP Service.
+	ExposeIngress ExposeMode = "ingress"
+)
+
+// ParseExposeMode validates s as an ExposeMode, defaulting to
+// ExposePortForward for an empty string.
+func ParseExposeMode(s string) (ExposeMode, error) {
+	switch ExposeMode(s) {
+	case "":
+		return ExposePortForward, nil
+	case ExposePortForward, ExposeNodePort, ExposeLoadBalancer, ExposeIngress:
+		return ExposeMode(s), nil
+	default:
+		return "", fmt.Errorf("invalid --expose %q: must be one of port-forward, nodeport, loadbalancer, ingress", s)
+	}
+}
@@ -0,0 +1,86 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createIngress creates an Ingress routing opts.Host to the notebook's
+// Service. If opts.CertManagerIssuer is set, it's attached as a
+// cert-manager cluster-issuer annotation and a TLS block is added for
+// opts.Host, which is how cert-manager's HTTP-01/DNS-01 solvers are wired
+// up to mint a certificate for the Ingress.
+func (m *Manager) createIngress(ctx context.Context, opts CreateOptions) error {
+	pathType := networkingv1.PathTypePrefix
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        opts.Name,
+			Labels:      managedLabels(opts.Name),
+			Annotations: map[string]string{},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: opts.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     "/",
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: opts.Name,
+											Port: networkingv1.ServiceBackendPort{
+												Number: 8888,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if opts.IngressClass != "" {
+		ingress.Spec.IngressClassName = &opts.IngressClass
+	}
+
+	if opts.CertManagerIssuer != "" {
+		ingress.Annotations["cert-manager.io/cluster-issuer"] = opts.CertManagerIssuer
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{opts.Host},
+				SecretName: fmt.Sprintf("%s-tls", opts.Name),
+			},
+		}
+	}
+
+	if opts.AuthProxyURL != "" {
+		ingress.Annotations["nginx.ingress.kubernetes.io/auth-url"] = opts.AuthProxyURL
+		ingress.Annotations["nginx.ingress.kubernetes.io/auth-signin"] = opts.AuthProxyURL
+	}
+
+	result, err := m.clientset.NetworkingV1().Ingresses(m.namespace).Create(ctx, ingress, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created ingress %q.\n", result.GetObjectMeta().GetName())
+	return nil
+}
+
+func (m *Manager) deleteIngress(ctx context.Context, name string) error {
+	err := m.clientset.NetworkingV1().Ingresses(m.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
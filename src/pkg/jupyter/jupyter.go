@@ -0,0 +1,68 @@
+// Package jupyter provides the Manager type used to create, list, delete,
+// and connect to Jupyter notebook workloads running in a Kubernetes cluster.
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// ManagedByLabel is the label used to identify resources owned by this tool,
+// regardless of the notebook name.
+const ManagedByLabel = "app.kubernetes.io/managed-by"
+
+// ManagedByValue is the value of ManagedByLabel set on every resource this
+// tool creates.
+const ManagedByValue = "jupyter-kube"
+
+// NameLabel identifies the notebook a given resource belongs to.
+const NameLabel = "app.kubernetes.io/instance"
+
+// Manager creates, lists, and deletes Jupyter notebook workloads in a single
+// namespace, identifying "its" resources via ManagedByLabel.
+type Manager struct {
+	clientset kubernetes.Interface
+	namespace string
+}
+
+// NewManager returns a Manager scoped to namespace.
+func NewManager(clientset kubernetes.Interface, namespace string) *Manager {
+	return &Manager{clientset: clientset, namespace: namespace}
+}
+
+// Notebook describes a running (or partially running) notebook workload.
+type Notebook struct {
+	Name   string
+	Status string
+	// Kind is the workload backing the notebook: "Deployment" or
+	// "StatefulSet".
+	Kind string
+}
+
+// labelSelector returns the selector used to find every resource managed by
+// this tool, optionally scoped to a single notebook name.
+func labelSelector(name string) string {
+	selector := fmt.Sprintf("%s=%s", ManagedByLabel, ManagedByValue)
+	if name != "" {
+		selector = fmt.Sprintf("%s,%s=%s", selector, NameLabel, name)
+	}
+	return selector
+}
+
+func managedLabels(name string) map[string]string {
+	return map[string]string{
+		ManagedByLabel: ManagedByValue,
+		NameLabel:      name,
+	}
+}
+
+// ensureContext is a small helper so call sites read naturally; it exists to
+// keep context.TODO() out of every method body below.
+func ensureContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.TODO()
+	}
+	return ctx
+}
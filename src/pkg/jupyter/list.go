@@ -0,0 +1,103 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns every notebook this tool manages in the configured
+// namespace, whether backed by a Deployment or a StatefulSet.
+func (m *Manager) List(ctx context.Context) ([]Notebook, error) {
+	return m.listNotebooks(ensureContext(ctx), "")
+}
+
+// Get returns the single notebook named name, whether backed by a
+// Deployment or a StatefulSet.
+func (m *Manager) Get(ctx context.Context, name string) (*Notebook, error) {
+	notebooks, err := m.listNotebooks(ensureContext(ctx), name)
+	if err != nil {
+		return nil, err
+	}
+	if len(notebooks) == 0 {
+		return nil, fmt.Errorf("notebook %q not found", name)
+	}
+	return &notebooks[0], nil
+}
+
+// listNotebooks returns every notebook matching labelSelector(name), or
+// every notebook this tool manages if name is empty.
+func (m *Manager) listNotebooks(ctx context.Context, name string) ([]Notebook, error) {
+	notebooks := make([]Notebook, 0)
+
+	deployments, err := m.clientset.AppsV1().Deployments(m.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments: %w", err)
+	}
+	for _, d := range deployments.Items {
+		status := "Pending"
+		if d.Status.ReadyReplicas > 0 {
+			status = "Running"
+		}
+		notebooks = append(notebooks, Notebook{Name: d.Name, Status: status, Kind: "Deployment"})
+	}
+
+	statefulSets, err := m.clientset.AppsV1().StatefulSets(m.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing statefulsets: %w", err)
+	}
+	for _, s := range statefulSets.Items {
+		status := "Pending"
+		if s.Status.ReadyReplicas > 0 {
+			status = "Running"
+		}
+		notebooks = append(notebooks, Notebook{Name: s.Name, Status: status, Kind: "StatefulSet"})
+	}
+
+	return notebooks, nil
+}
+
+// DeleteOptions configures Manager.Delete.
+type DeleteOptions struct {
+	// KeepStorage leaves the notebook's workspace PVC in place so a future
+	// Create against the same name picks its data back up.
+	KeepStorage bool
+}
+
+// Delete removes the notebook's workload (Deployment or StatefulSet) and
+// Service, and its workspace PVC unless opts.KeepStorage is set. Both
+// workload kinds are attempted since Delete doesn't require the caller to
+// know which one backs the notebook.
+func (m *Manager) Delete(ctx context.Context, name string, opts DeleteOptions) error {
+	ctx = ensureContext(ctx)
+
+	for _, creator := range []WorkloadCreator{deploymentCreator{}, statefulSetCreator{}} {
+		if err := creator.Delete(ctx, m, name); err != nil && !apierrors.IsNotFound(err) {
+			fmt.Printf("Warning: failed to delete workload %q: %v\n", name, err)
+		}
+	}
+
+	serviceClient := m.clientset.CoreV1().Services(m.namespace)
+	if err := serviceClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		fmt.Printf("Warning: failed to delete service %q: %v\n", name, err)
+	}
+
+	if err := m.deleteIngress(ctx, name); err != nil {
+		fmt.Printf("Warning: failed to delete ingress %q: %v\n", name, err)
+	}
+
+	if !opts.KeepStorage {
+		if err := m.deletePVC(ctx, name); err != nil {
+			fmt.Printf("Warning: failed to delete workspace PVC for %q: %v\n", name, err)
+		}
+	}
+
+	fmt.Printf("Deleted notebook %q.\n", name)
+	return nil
+}
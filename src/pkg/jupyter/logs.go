@@ -0,0 +1,36 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Logs streams the logs of the notebook's pod to w. If follow is true it
+// keeps streaming until the context is canceled.
+func (m *Manager) Logs(ctx context.Context, name string, follow bool, w io.Writer) error {
+	ctx = ensureContext(ctx)
+
+	pods, err := m.clientset.CoreV1().Pods(m.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector(name),
+	})
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pods found for notebook %q", name)
+	}
+
+	req := m.clientset.CoreV1().Pods(m.namespace).GetLogs(pods.Items[0].Name, &apiv1.PodLogOptions{Follow: follow})
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("opening log stream: %w", err)
+	}
+	defer stream.Close()
+
+	_, err = io.Copy(w, stream)
+	return err
+}
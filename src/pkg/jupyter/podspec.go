@@ -0,0 +1,124 @@
+package jupyter
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// workspaceVolumeName is the name given to the workspace volume on both the
+// Deployment (PVC-backed) and StatefulSet (volumeClaimTemplate-backed)
+// code paths.
+const workspaceVolumeName = "workspace"
+
+// notebookContainerName is the name of the container every notebook
+// workload runs, used both when building the pod spec and as the exec
+// target for Upload/Download.
+const notebookContainerName = "jupyter-container"
+
+// notebookContainer builds the single container every notebook workload
+// runs, before any workload-specific volume mounts are attached.
+func notebookContainer(opts CreateOptions) apiv1.Container {
+	image := opts.Image
+	if image == "" {
+		image = "jupyter/base-notebook:latest"
+	}
+
+	container := apiv1.Container{
+		Name:  notebookContainerName,
+		Image: image,
+		Ports: []apiv1.ContainerPort{
+			{
+				Name:          "http",
+				Protocol:      apiv1.ProtocolTCP,
+				ContainerPort: 8888,
+			},
+		},
+		Resources: apiv1.ResourceRequirements{
+			Requests: apiv1.ResourceList{
+				apiv1.ResourceCPU:    resource.MustParse(opts.CPU),
+				apiv1.ResourceMemory: resource.MustParse(opts.Memory),
+			},
+			Limits: apiv1.ResourceList{
+				apiv1.ResourceCPU:    resource.MustParse(opts.CPU),
+				apiv1.ResourceMemory: resource.MustParse(opts.Memory),
+			},
+		},
+	}
+
+	if opts.GPU > 0 {
+		container.Resources.Limits["nvidia.com/gpu"] = resource.MustParse(fmt.Sprintf("%d", opts.GPU))
+	}
+
+	command := opts.Command
+	if len(command) == 0 {
+		command = []string{"start-notebook.sh"}
+	}
+	container.Command = command
+
+	if opts.Token != "" {
+		container.Args = []string{fmt.Sprintf("--NotebookApp.token=%s", opts.Token)}
+	} else {
+		container.Args = []string{"--NotebookApp.token="}
+	}
+
+	return container
+}
+
+// attachWorkspacePVC mounts an existing, named PVC into podSpec's container
+// as the workspace volume. Used by the Deployment path, where the PVC is
+// created up front by ensurePVC.
+func attachWorkspacePVC(podSpec *apiv1.PodSpec, claimName string) {
+	podSpec.Volumes = append(podSpec.Volumes, apiv1.Volume{
+		Name: workspaceVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, workspaceVolumeMount())
+}
+
+// workspaceVolumeMount is the VolumeMount every notebook container gets
+// when it has a workspace, whether backed by a PVC (Deployment) or a
+// volumeClaimTemplate (StatefulSet).
+func workspaceVolumeMount() apiv1.VolumeMount {
+	return apiv1.VolumeMount{
+		Name:      workspaceVolumeName,
+		MountPath: WorkspaceMountPath,
+	}
+}
+
+// applyScheduling sets the pod-level scheduling fields (node selector,
+// tolerations, image pull secret, service account) common to both the
+// Deployment and StatefulSet code paths. opts.GPUType, if set, is merged
+// into the node selector as nvidia.com/gpu.product so GPU requests land on
+// the matching node pool in a multi-tenant cluster.
+func applyScheduling(podSpec *apiv1.PodSpec, opts CreateOptions) {
+	if opts.GPUType != "" {
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = map[string]string{}
+		}
+		podSpec.NodeSelector["nvidia.com/gpu.product"] = opts.GPUType
+	}
+	for k, v := range opts.NodeSelector {
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = map[string]string{}
+		}
+		podSpec.NodeSelector[k] = v
+	}
+
+	podSpec.Tolerations = append(podSpec.Tolerations, opts.Tolerations...)
+
+	if opts.ImagePullSecret != "" {
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, apiv1.LocalObjectReference{
+			Name: opts.ImagePullSecret,
+		})
+	}
+
+	if opts.ServiceAccount != "" {
+		podSpec.ServiceAccountName = opts.ServiceAccount
+	}
+}
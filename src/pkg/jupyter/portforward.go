@@ -0,0 +1,62 @@
+package jupyter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// Attach waits for the notebook's pod to become ready, then port-forwards
+// local port 8888 to it and blocks until stopChan is closed. readyTimeout
+// of 0 uses DefaultReadyTimeout.
+func (m *Manager) Attach(ctx context.Context, config *rest.Config, name string, readyTimeout time.Duration, stopChan <-chan struct{}) error {
+	ctx = ensureContext(ctx)
+
+	fmt.Printf("Waiting for notebook %q to become ready...\n", name)
+	podName, err := m.WaitForReady(ctx, name, readyTimeout)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/portforward", m.namespace, podName)
+	hostIP := config.Host
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return err
+	}
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, &url.URL{Scheme: "https", Path: path, Host: hostIP})
+
+	readyChan := make(chan struct{}, 1)
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+
+	forwarder, err := portforward.New(dialer, []string{"8888:8888"}, stopChan, readyChan, out, errOut)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+
+	select {
+	case <-readyChan:
+	case err := <-errCh:
+		return fmt.Errorf("port forwarding error: %w", err)
+	}
+
+	if len(errOut.String()) != 0 {
+		return fmt.Errorf("port forwarding error: %s", errOut.String())
+	}
+
+	return <-errCh
+}
@@ -0,0 +1,105 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DefaultReadyTimeout is how long WaitForReady waits for a notebook's pod
+// to become ready before giving up.
+const DefaultReadyTimeout = 5 * time.Minute
+
+// WaitForReady blocks until a pod for the named notebook is PodRunning with
+// every container ready, printing progress (scheduling, image pulls,
+// container starts) as the pod comes up. It returns the ready pod's name.
+func (m *Manager) WaitForReady(ctx context.Context, name string, timeout time.Duration) (string, error) {
+	ctx = ensureContext(ctx)
+	if timeout <= 0 {
+		timeout = DefaultReadyTimeout
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(m.clientset, 0,
+		informers.WithNamespace(m.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = labelSelector(name)
+		}),
+	)
+	podInformer := factory.Core().V1().Pods().Informer()
+
+	readyChan := make(chan string, 1)
+	reported := make(map[string]string)
+
+	onPod := func(obj interface{}) {
+		pod, ok := obj.(*apiv1.Pod)
+		if !ok {
+			return
+		}
+		reportProgress(pod, reported)
+		if podReady(pod) {
+			select {
+			case readyChan <- pod.Name:
+			default:
+			}
+		}
+	}
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    onPod,
+		UpdateFunc: func(_, newObj interface{}) { onPod(newObj) },
+	})
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go factory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, podInformer.HasSynced) {
+		return "", fmt.Errorf("failed to sync pod informer for notebook %q", name)
+	}
+
+	select {
+	case podName := <-readyChan:
+		return podName, nil
+	case <-time.After(timeout):
+		return "", fmt.Errorf("timed out after %s waiting for notebook %q to become ready", timeout, name)
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// podReady reports whether pod is running with every container ready.
+func podReady(pod *apiv1.Pod) bool {
+	if pod.Status.Phase != apiv1.PodRunning {
+		return false
+	}
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if !cs.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// reportProgress prints pod.Name's status only when it differs from the
+// last status printed for that pod, so repeated informer updates with no
+// change don't spam the terminal.
+func reportProgress(pod *apiv1.Pod, reported map[string]string) {
+	status := string(pod.Status.Phase)
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Waiting != nil {
+			status = cs.State.Waiting.Reason
+		}
+	}
+
+	if reported[pod.Name] == status {
+		return
+	}
+	reported[pod.Name] = status
+	fmt.Printf("  %s: %s\n", pod.Name, status)
+}
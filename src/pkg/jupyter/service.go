@@ -0,0 +1,51 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createService creates the notebook's Service. A headless (ClusterIP:
+// None) Service is used for StatefulSet-backed notebooks so the pod gets a
+// stable DNS name, taking priority over exposeMode; otherwise exposeMode
+// picks the Service type (a ClusterIP Service fronts both port-forward and
+// ingress exposure).
+func (m *Manager) createService(ctx context.Context, name string, headless bool, exposeMode ExposeMode) error {
+	serviceClient := m.clientset.CoreV1().Services(m.namespace)
+	service := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: managedLabels(name),
+		},
+		Spec: apiv1.ServiceSpec{
+			Selector: managedLabels(name),
+			Ports: []apiv1.ServicePort{
+				{
+					Name:     "http",
+					Protocol: apiv1.ProtocolTCP,
+					Port:     8888,
+				},
+			},
+			Type: apiv1.ServiceTypeClusterIP,
+		},
+	}
+
+	switch {
+	case headless:
+		service.Spec.ClusterIP = apiv1.ClusterIPNone
+	case exposeMode == ExposeNodePort:
+		service.Spec.Type = apiv1.ServiceTypeNodePort
+	case exposeMode == ExposeLoadBalancer:
+		service.Spec.Type = apiv1.ServiceTypeLoadBalancer
+	}
+
+	result, err := serviceClient.Create(ctx, service, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created service %q.\n", result.GetObjectMeta().GetName())
+	return nil
+}
@@ -0,0 +1,98 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// statefulSetCreator runs a notebook as a single-replica StatefulSet
+// fronted by a headless Service, giving the pod a stable DNS name. Storage,
+// if requested, is provisioned per-replica via a volumeClaimTemplate rather
+// than a standalone PVC.
+type statefulSetCreator struct{}
+
+func (statefulSetCreator) Create(ctx context.Context, m *Manager, opts CreateOptions) error {
+	if err := m.createStatefulSet(ctx, opts); err != nil {
+		return fmt.Errorf("creating statefulset: %w", err)
+	}
+	if err := m.createService(ctx, opts.Name, true, opts.Expose); err != nil {
+		return fmt.Errorf("creating service: %w", err)
+	}
+	return nil
+}
+
+func (statefulSetCreator) Delete(ctx context.Context, m *Manager, name string) error {
+	statefulSetsClient := m.clientset.AppsV1().StatefulSets(m.namespace)
+	if err := statefulSetsClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Manager) createStatefulSet(ctx context.Context, opts CreateOptions) error {
+	statefulSetsClient := m.clientset.AppsV1().StatefulSets(m.namespace)
+
+	container := notebookContainer(opts)
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Name,
+			Labels: managedLabels(opts.Name),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    int32Ptr(1),
+			ServiceName: opts.Name,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: managedLabels(opts.Name),
+			},
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: managedLabels(opts.Name),
+				},
+				Spec: apiv1.PodSpec{
+					Containers: []apiv1.Container{container},
+				},
+			},
+		},
+	}
+
+	if opts.Storage != "" {
+		statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+			statefulSet.Spec.Template.Spec.Containers[0].VolumeMounts, workspaceVolumeMount())
+
+		accessMode := apiv1.ReadWriteOnce
+		if opts.StorageRWX {
+			accessMode = apiv1.ReadWriteMany
+		}
+		pvcTemplate := apiv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   workspaceVolumeName,
+				Labels: managedLabels(opts.Name),
+			},
+			Spec: apiv1.PersistentVolumeClaimSpec{
+				AccessModes: []apiv1.PersistentVolumeAccessMode{accessMode},
+				Resources: apiv1.ResourceRequirements{
+					Requests: apiv1.ResourceList{
+						apiv1.ResourceStorage: resource.MustParse(opts.Storage),
+					},
+				},
+			},
+		}
+		if opts.StorageClass != "" {
+			pvcTemplate.Spec.StorageClassName = &opts.StorageClass
+		}
+		statefulSet.Spec.VolumeClaimTemplates = append(statefulSet.Spec.VolumeClaimTemplates, pvcTemplate)
+	}
+	applyScheduling(&statefulSet.Spec.Template.Spec, opts)
+
+	result, err := statefulSetsClient.Create(ctx, statefulSet, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created statefulset %q.\n", result.GetObjectMeta().GetName())
+	return nil
+}
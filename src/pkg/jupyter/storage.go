@@ -0,0 +1,92 @@
+package jupyter
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkspaceMountPath is where a notebook's PVC, if any, is mounted inside
+// the container.
+const WorkspaceMountPath = "/home/jovyan/work"
+
+// pvcName returns the deterministic PVC name for a notebook's Deployment-path,
+// standalone workspace PVC.
+func pvcName(notebookName string) string {
+	return fmt.Sprintf("%s-workspace", notebookName)
+}
+
+// statefulSetPVCName returns the deterministic PVC name Kubernetes derives
+// for the workspace volumeClaimTemplate on a notebook's StatefulSet: the
+// template name, the StatefulSet name, then the ordinal. createStatefulSet
+// always runs a single replica, so the ordinal is always 0.
+func statefulSetPVCName(notebookName string) string {
+	return fmt.Sprintf("%s-%s-0", workspaceVolumeName, notebookName)
+}
+
+// ensurePVC creates the notebook's workspace PVC if it doesn't already
+// exist, and returns its name. An existing PVC is reused as-is rather than
+// recreated, so re-running create against a notebook whose PVC survived a
+// prior delete picks its data back up.
+func (m *Manager) ensurePVC(ctx context.Context, opts CreateOptions) (string, error) {
+	name := pvcName(opts.Name)
+	pvcClient := m.clientset.CoreV1().PersistentVolumeClaims(m.namespace)
+
+	if _, err := pvcClient.Get(ctx, name, metav1.GetOptions{}); err == nil {
+		fmt.Printf("Reusing existing PVC %q.\n", name)
+		return name, nil
+	} else if !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("checking for existing PVC: %w", err)
+	}
+
+	accessMode := apiv1.ReadWriteOnce
+	if opts.StorageRWX {
+		accessMode = apiv1.ReadWriteMany
+	}
+
+	pvc := &apiv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: managedLabels(opts.Name),
+		},
+		Spec: apiv1.PersistentVolumeClaimSpec{
+			AccessModes: []apiv1.PersistentVolumeAccessMode{accessMode},
+			Resources: apiv1.ResourceRequirements{
+				Requests: apiv1.ResourceList{
+					apiv1.ResourceStorage: resource.MustParse(opts.Storage),
+				},
+			},
+		},
+	}
+	if opts.StorageClass != "" {
+		pvc.Spec.StorageClassName = &opts.StorageClass
+	}
+
+	result, err := pvcClient.Create(ctx, pvc, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	fmt.Printf("Created PVC %q.\n", result.GetObjectMeta().GetName())
+	return name, nil
+}
+
+// deletePVC deletes the notebook's workspace PVC, if one exists, trying
+// both the Deployment path's standalone PVC name and the StatefulSet
+// path's volumeClaimTemplate-derived name, since Delete doesn't require
+// the caller to know which workload kind backs the notebook and
+// StatefulSets don't delete their volumeClaimTemplate PVCs on their own.
+func (m *Manager) deletePVC(ctx context.Context, notebookName string) error {
+	pvcClient := m.clientset.CoreV1().PersistentVolumeClaims(m.namespace)
+
+	var firstErr error
+	for _, name := range []string{pvcName(notebookName), statefulSetPVCName(notebookName)} {
+		if err := pvcClient.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,16 @@
+package jupyter
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// generateToken returns a random hex token suitable for Jupyter's
+// --NotebookApp.token.
+func generateToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,200 @@
+package jupyter
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// Upload streams localDir into the notebook's workspace by piping a tar
+// archive into `tar -xmf -` running inside the notebook's pod.
+func (m *Manager) Upload(ctx context.Context, config *rest.Config, name, localDir string) error {
+	ctx = ensureContext(ctx)
+
+	podName, err := m.findPod(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(tarDirectory(localDir, pw))
+	}()
+
+	return m.exec(ctx, config, podName, []string{"tar", "-xmf", "-", "-C", WorkspaceMountPath}, pr, os.Stdout, os.Stderr)
+}
+
+// Download pulls remoteDir out of the notebook's workspace into localDir by
+// running `tar -cf -` inside the pod and extracting the resulting stream
+// locally.
+func (m *Manager) Download(ctx context.Context, config *rest.Config, name, remoteDir, localDir string) error {
+	ctx = ensureContext(ctx)
+
+	podName, err := m.findPod(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- m.exec(ctx, config, podName, []string{"tar", "-cf", "-", "-C", remoteDir, "."}, nil, pw, os.Stderr)
+		pw.Close()
+	}()
+
+	if err := untarDirectory(pr, localDir); err != nil {
+		return err
+	}
+	return <-errCh
+}
+
+func (m *Manager) findPod(ctx context.Context, name string) (string, error) {
+	pods, err := m.clientset.CoreV1().Pods(m.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector(name),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(pods.Items) == 0 {
+		return "", fmt.Errorf("no pods found for notebook %q", name)
+	}
+	return pods.Items[0].Name, nil
+}
+
+// exec runs command inside the notebook container, wiring stdin/stdout/
+// stderr to the given streams.
+func (m *Manager) exec(ctx context.Context, config *rest.Config, podName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	req := m.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(podName).
+		Namespace(m.namespace).
+		SubResource("exec")
+
+	req.VersionedParams(&apiv1.PodExecOptions{
+		Container: notebookContainerName,
+		Command:   command,
+		Stdin:     stdin != nil,
+		Stdout:    stdout != nil,
+		Stderr:    stderr != nil,
+	}, runtime.NewParameterCodec(scheme.Scheme))
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("creating executor: %w", err)
+	}
+
+	return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+}
+
+// tarDirectory writes a tar archive of dir's contents to w.
+func tarDirectory(dir string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	defer tw.Close()
+
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+}
+
+// untarDirectory extracts the tar archive read from r into dir, which is
+// created if it doesn't already exist.
+func untarDirectory(r io.Reader, dir string) error {
+	dir = filepath.Clean(dir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, err := safeJoin(dir, header.Name)
+		if err != nil {
+			return fmt.Errorf("extracting %q: %w", header.Name, err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			f.Close()
+		}
+	}
+}
+
+// safeJoin joins dir with name, a path from a tar entry, and rejects any
+// result that escapes dir. The tar stream comes from `tar -cf -` run inside
+// the notebook pod, which may be running an untrusted --image, so a
+// malicious "../" entry must not be able to write outside dir (zip-slip).
+func safeJoin(dir, name string) (string, error) {
+	target := filepath.Join(dir, name)
+	if target != dir && !strings.HasPrefix(target, dir+string(os.PathSeparator)) {
+		return "", fmt.Errorf("tar entry %q escapes destination directory", name)
+	}
+	return target, nil
+}
@@ -0,0 +1,20 @@
+package jupyter
+
+import "context"
+
+// WorkloadCreator builds and tears down the pod-running workload backing a
+// notebook (a Deployment or a StatefulSet). Create also creates the
+// notebook's Service; Delete removes only the workload itself.
+type WorkloadCreator interface {
+	Create(ctx context.Context, m *Manager, opts CreateOptions) error
+	Delete(ctx context.Context, m *Manager, name string) error
+}
+
+// workloadCreatorFor picks the WorkloadCreator implementation matching
+// opts.Stateful.
+func workloadCreatorFor(opts CreateOptions) WorkloadCreator {
+	if opts.Stateful {
+		return statefulSetCreator{}
+	}
+	return deploymentCreator{}
+}